@@ -0,0 +1,87 @@
+package googleauth
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+var errNoMissingScopes = errors.New("googleauth: granted scopes already cover all requested services")
+
+// GrantedScopes splits a token's space-separated "scope" field (as returned
+// by Google's token endpoint or https://oauth2.googleapis.com/tokeninfo)
+// into canonicalized, deduplicated scope URLs.
+func GrantedScopes(tokenScope string) []string {
+	fields := strings.Fields(tokenScope)
+	seen := make(map[string]struct{}, len(fields))
+	out := make([]string, 0, len(fields))
+
+	for _, f := range fields {
+		canon := CanonicalizeScope(f)
+		if canon == "" {
+			continue
+		}
+
+		if _, dup := seen[canon]; dup {
+			continue
+		}
+
+		seen[canon] = struct{}{}
+		out = append(out, canon)
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+// MissingScopes returns the scopes want's services require that aren't
+// already present in granted, so a caller can request only the delta via
+// Google's incremental authorization flow instead of re-requesting
+// everything.
+func MissingScopes(granted []string, want []Service) ([]string, error) {
+	wantScopes, err := ScopesForServices(want)
+	if err != nil {
+		return nil, err
+	}
+
+	grantedSet := make(map[string]struct{}, len(granted))
+	for _, g := range granted {
+		grantedSet[CanonicalizeScope(g)] = struct{}{}
+	}
+
+	missing := make([]string, 0, len(wantScopes))
+	for _, s := range wantScopes {
+		if _, ok := grantedSet[s]; !ok {
+			missing = append(missing, s)
+		}
+	}
+
+	sort.Strings(missing)
+
+	return missing, nil
+}
+
+// IncrementalAuthURL builds a consent URL that requests only the scopes
+// want's services are missing from granted, with include_granted_scopes set
+// so Google preserves the previously granted scopes instead of narrowing
+// the token to just what's listed. It returns an error if granted already
+// covers everything want requires, since an auth URL with no scope
+// parameter is rejected by Google's authorization endpoint.
+func IncrementalAuthURL(cfg *oauth2.Config, granted []string, want []Service, state string) (string, error) {
+	missing, err := MissingScopes(granted, want)
+	if err != nil {
+		return "", err
+	}
+
+	if len(missing) == 0 {
+		return "", errNoMissingScopes
+	}
+
+	incremental := *cfg
+	incremental.Scopes = missing
+
+	return incremental.AuthCodeURL(state, oauth2.SetAuthURLParam("include_granted_scopes", "true")), nil
+}
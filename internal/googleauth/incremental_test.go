@@ -0,0 +1,109 @@
+package googleauth
+
+import (
+	"net/url"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestGrantedScopes(t *testing.T) {
+	got := GrantedScopes("drive.readonly https://www.googleapis.com/auth/tasks drive.readonly openid")
+	want := []string{
+		"https://www.googleapis.com/auth/drive.readonly",
+		"https://www.googleapis.com/auth/tasks",
+		scopeOpenID,
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for _, w := range want {
+		if !containsScope(got, w) {
+			t.Fatalf("missing %q in %v", w, got)
+		}
+	}
+}
+
+func TestMissingScopes_DriveGrantedDocsMissingDocuments(t *testing.T) {
+	granted, err := ScopesForServices([]Service{ServiceDrive})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	missing, err := MissingScopes(granted, []Service{ServiceDocs})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if len(missing) != 1 || missing[0] != "https://www.googleapis.com/auth/documents" {
+		t.Fatalf("unexpected missing scopes: %v", missing)
+	}
+}
+
+func TestMissingScopes_NothingGranted(t *testing.T) {
+	missing, err := MissingScopes(nil, []Service{ServiceTasks})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if !containsScope(missing, "https://www.googleapis.com/auth/tasks") {
+		t.Fatalf("expected tasks scope in %v", missing)
+	}
+}
+
+func TestIncrementalAuthURL_NothingMissingErrors(t *testing.T) {
+	cfg := &oauth2.Config{
+		ClientID: "test-client-id",
+		Endpoint: oauth2.Endpoint{AuthURL: "https://accounts.google.com/o/oauth2/auth"},
+	}
+
+	granted, err := ScopesForManage([]Service{ServiceTasks})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if _, err := IncrementalAuthURL(cfg, granted, []Service{ServiceTasks}, "xyz"); err == nil {
+		t.Fatalf("expected error when nothing is missing")
+	}
+}
+
+func TestIncrementalAuthURL_OnlyListsMissingScope(t *testing.T) {
+	cfg := &oauth2.Config{
+		ClientID: "test-client-id",
+		Endpoint: oauth2.Endpoint{AuthURL: "https://accounts.google.com/o/oauth2/auth"},
+	}
+
+	granted, err := ScopesForServices([]Service{ServiceDrive})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	authURL, err := IncrementalAuthURL(cfg, granted, []Service{ServiceDocs}, "xyz")
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("parse auth URL: %v", err)
+	}
+
+	scope := parsed.Query().Get("scope")
+	if scope != "https://www.googleapis.com/auth/documents" {
+		t.Fatalf("unexpected scope param: %q", scope)
+	}
+
+	if parsed.Query().Get("include_granted_scopes") != "true" {
+		t.Fatalf("expected include_granted_scopes=true in %q", authURL)
+	}
+
+	if parsed.Query().Get("state") != "xyz" {
+		t.Fatalf("unexpected state param in %q", authURL)
+	}
+
+	if cfg.Scopes != nil {
+		t.Fatalf("IncrementalAuthURL must not mutate the caller's config, got scopes %v", cfg.Scopes)
+	}
+}
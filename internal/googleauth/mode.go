@@ -0,0 +1,92 @@
+package googleauth
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Mode selects how much permission to request for a service. Most services
+// only support ModeFull and ModeReadOnly today; room is left for Gmail-only
+// modes like send-only or compose-only consent grants.
+type Mode string
+
+const (
+	// ModeFull requests the service's normal (read/write) scopes.
+	ModeFull Mode = "full"
+	// ModeReadOnly requests the service's reduced-permission scopes, for
+	// services that support one. Services without a read-only tier fall
+	// back to their full scopes.
+	ModeReadOnly Mode = "readonly"
+)
+
+var errUnknownMode = fmt.Errorf("unknown mode")
+
+// ScopesWithMode returns the scopes for service under mode. ModeFull returns
+// the same result as Scopes; ModeReadOnly returns the service's reduced
+// scopes if it has any, otherwise falling back to the full scopes.
+func ScopesWithMode(service Service, mode Mode) ([]string, error) {
+	info, ok := reg.get(service)
+	if !ok {
+		return nil, errUnknownService
+	}
+
+	switch mode {
+	case ModeFull, "":
+		return append([]string(nil), info.scopes...), nil
+	case ModeReadOnly:
+		if len(info.readOnlyScopes) > 0 {
+			return append([]string(nil), info.readOnlyScopes...), nil
+		}
+
+		return append([]string(nil), info.scopes...), nil
+	default:
+		return nil, fmt.Errorf("%w %q", errUnknownMode, mode)
+	}
+}
+
+// ScopesForServicesWithModes returns the union of scopes for the services in
+// modes, each resolved under its own Mode.
+func ScopesForServicesWithModes(modes map[Service]Mode) ([]string, error) {
+	set := make(map[string]struct{})
+
+	for svc, mode := range modes {
+		scopes, err := ScopesWithMode(svc, mode)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range scopes {
+			set[s] = struct{}{}
+		}
+	}
+
+	out := make([]string, 0, len(set))
+	for s := range set {
+		out = append(out, s)
+	}
+
+	sort.Strings(out)
+
+	return out, nil
+}
+
+// ScopesForManageWithModes is ScopesForManage's per-service-mode
+// counterpart: it resolves each service's scopes under its requested Mode
+// before adding the OIDC identity scopes ScopesForManage always includes.
+func ScopesForManageWithModes(modes map[Service]Mode, extra ...string) ([]string, error) {
+	scopes, err := ScopesForServicesWithModes(modes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(extra) > 0 {
+		parsedExtra, err := ParseScopes(extra)
+		if err != nil {
+			return nil, err
+		}
+
+		scopes = mergeScopes(scopes, parsedExtra)
+	}
+
+	return mergeScopes(scopes, []string{scopeOpenID, scopeEmail, scopeUserinfoEmail}), nil
+}
@@ -0,0 +1,98 @@
+package googleauth
+
+import "testing"
+
+func TestScopesWithMode_ReadOnly(t *testing.T) {
+	scopes, err := ScopesWithMode(ServiceDrive, ModeReadOnly)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if !containsScope(scopes, "https://www.googleapis.com/auth/drive.readonly") {
+		t.Fatalf("missing readonly scope in %v", scopes)
+	}
+
+	if containsScope(scopes, "https://www.googleapis.com/auth/drive") {
+		t.Fatalf("unexpected full scope in readonly result: %v", scopes)
+	}
+}
+
+func TestScopesWithMode_FullDefaultsWithoutReadOnlyTier(t *testing.T) {
+	full, err := ScopesWithMode(ServicePeople, ModeFull)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	readonly, err := ScopesWithMode(ServicePeople, ModeReadOnly)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if len(full) == 0 || len(readonly) == 0 {
+		t.Fatalf("expected non-empty scopes, got full=%v readonly=%v", full, readonly)
+	}
+
+	if len(full) != len(readonly) {
+		t.Fatalf("expected read-only to fall back to full scopes, got full=%v readonly=%v", full, readonly)
+	}
+
+	for _, s := range full {
+		if !containsScope(readonly, s) {
+			t.Fatalf("expected read-only to fall back to full scopes, got full=%v readonly=%v", full, readonly)
+		}
+	}
+}
+
+func TestScopesWithMode_UnknownMode(t *testing.T) {
+	if _, err := ScopesWithMode(ServiceDrive, Mode("bogus")); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestScopesForServicesWithModes(t *testing.T) {
+	scopes, err := ScopesForServicesWithModes(map[Service]Mode{
+		ServiceDrive: ModeReadOnly,
+		ServiceGmail: ModeFull,
+	})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if !containsScope(scopes, "https://www.googleapis.com/auth/drive.readonly") {
+		t.Fatalf("missing drive readonly scope in %v", scopes)
+	}
+
+	if !containsScope(scopes, "https://mail.google.com/") {
+		t.Fatalf("missing gmail full scope in %v", scopes)
+	}
+}
+
+func TestScopesForManageWithModes_IncludesIdentityScopes(t *testing.T) {
+	scopes, err := ScopesForManageWithModes(map[Service]Mode{ServiceCalendar: ModeReadOnly})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	for _, want := range []string{scopeOpenID, scopeEmail, scopeUserinfoEmail, "https://www.googleapis.com/auth/calendar.readonly"} {
+		if !containsScope(scopes, want) {
+			t.Fatalf("missing %q in %v", want, scopes)
+		}
+	}
+}
+
+func TestServicesInfo_ReadOnlyScopes(t *testing.T) {
+	infos := ServicesInfo()
+
+	driveInfo, found := findServiceInfo(infos, ServiceDrive)
+	if !found {
+		t.Fatalf("missing drive info")
+	}
+
+	if len(driveInfo.ReadOnlyScopes) == 0 {
+		t.Fatalf("expected drive read-only scopes")
+	}
+
+	if markdown := ServicesMarkdown(infos); markdown == "" {
+		t.Fatalf("expected markdown output")
+	}
+}
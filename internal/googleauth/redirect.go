@@ -0,0 +1,29 @@
+package googleauth
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// extractCodeAndState pulls the "code" and "state" query parameters off the
+// redirect URL a browser lands on after the user completes an installed-app
+// consent flow (e.g. http://localhost:PORT/?code=...&state=...).
+func extractCodeAndState(redirect string) (code, state string, err error) {
+	u, err := url.Parse(redirect)
+	if err != nil {
+		return "", "", fmt.Errorf("googleauth: parse redirect URL: %w", err)
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return "", "", fmt.Errorf("googleauth: invalid redirect URL %q", redirect)
+	}
+
+	q := u.Query()
+
+	code = q.Get("code")
+	if code == "" {
+		return "", "", fmt.Errorf("googleauth: redirect URL %q missing code parameter", redirect)
+	}
+
+	return code, q.Get("state"), nil
+}
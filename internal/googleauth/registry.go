@@ -0,0 +1,122 @@
+package googleauth
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	errInvalidServiceInfo   = errors.New("invalid service info")
+	errServiceAlreadyExists = errors.New("service already registered")
+)
+
+// serviceRegistry holds every known service, built-in and caller-registered,
+// in insertion order. Built-ins are registered by this package's init, so
+// they always sort before custom entries.
+type serviceRegistry struct {
+	mu    sync.RWMutex
+	order []Service
+	infos map[Service]serviceInfo
+}
+
+var reg = &serviceRegistry{infos: make(map[Service]serviceInfo)}
+
+func init() {
+	for _, svc := range builtinServiceOrder {
+		if err := reg.register(svc, builtinServiceInfo[svc]); err != nil {
+			panic(fmt.Sprintf("googleauth: invalid built-in service %q: %v", svc, err))
+		}
+	}
+}
+
+func (r *serviceRegistry) register(svc Service, info serviceInfo) error {
+	if svc == "" {
+		return fmt.Errorf("%w: empty service name", errInvalidServiceInfo)
+	}
+
+	if len(info.scopes) == 0 {
+		return fmt.Errorf("%w: %q has no scopes", errInvalidServiceInfo, svc)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.infos[svc]; exists {
+		return fmt.Errorf("%w: %q", errServiceAlreadyExists, svc)
+	}
+
+	r.infos[svc] = info
+	r.order = append(r.order, svc)
+
+	return nil
+}
+
+func (r *serviceRegistry) unregister(svc Service) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.infos[svc]; !exists {
+		return
+	}
+
+	delete(r.infos, svc)
+
+	for i, s := range r.order {
+		if s == svc {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+}
+
+func (r *serviceRegistry) get(svc Service) (serviceInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info, ok := r.infos[svc]
+
+	return info, ok
+}
+
+func (r *serviceRegistry) serviceOrder() []Service {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]Service, len(r.order))
+	copy(out, r.order)
+
+	return out
+}
+
+// Register adds a custom service (e.g. YouTube Data, Cloud Storage, Admin
+// SDK) to the registry so it flows through every public lookup —
+// ParseService, AllServices, Scopes, ServicesInfo, ServicesMarkdown,
+// ScopesForManage, and so on — alongside the built-in services. It is safe
+// for concurrent use. Registering a Service that already exists, built-in
+// or custom, returns an error rather than overwriting it; call Unregister
+// first if that's the intent.
+func Register(svc Service, info ServiceInfo) error {
+	return reg.register(svc, serviceInfo{
+		scopes:         append([]string(nil), info.Scopes...),
+		readOnlyScopes: append([]string(nil), info.ReadOnlyScopes...),
+		user:           info.User,
+		apis:           append([]string(nil), info.APIs...),
+		note:           info.Note,
+	})
+}
+
+// MustRegister calls Register and panics if it returns an error. It is
+// meant for package-init-time registration, where an invalid entry is a
+// programmer error rather than something a caller should recover from.
+func MustRegister(svc Service, info ServiceInfo) {
+	if err := Register(svc, info); err != nil {
+		panic(err)
+	}
+}
+
+// Unregister removes svc from the registry, built-in or custom. It is a
+// no-op if svc was never registered.
+func Unregister(svc Service) {
+	reg.unregister(svc)
+}
@@ -0,0 +1,106 @@
+package googleauth
+
+import (
+	"strings"
+	"testing"
+)
+
+const testService Service = "synthetic"
+
+func registerTestService(t *testing.T) {
+	t.Helper()
+
+	if err := Register(testService, ServiceInfo{
+		User:   true,
+		Scopes: []string{"https://www.googleapis.com/auth/synthetic"},
+		APIs:   []string{"Synthetic API"},
+		Note:   "test-only service",
+	}); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	t.Cleanup(func() { Unregister(testService) })
+}
+
+func TestRegister_FlowsThroughPublicAPI(t *testing.T) {
+	registerTestService(t)
+
+	if _, err := ParseService("synthetic"); err != nil {
+		t.Fatalf("ParseService: %v", err)
+	}
+
+	found := false
+	for _, svc := range AllServices() {
+		if svc == testService {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Fatalf("AllServices missing registered service")
+	}
+
+	scopes, err := Scopes(testService)
+	if err != nil {
+		t.Fatalf("Scopes: %v", err)
+	}
+
+	if !containsScope(scopes, "https://www.googleapis.com/auth/synthetic") {
+		t.Fatalf("missing scope in %v", scopes)
+	}
+
+	infos := ServicesInfo()
+	if _, ok := findServiceInfo(infos, testService); !ok {
+		t.Fatalf("ServicesInfo missing registered service")
+	}
+
+	if markdown := ServicesMarkdown(infos); !strings.Contains(markdown, "synthetic") {
+		t.Fatalf("ServicesMarkdown missing registered service: %q", markdown)
+	}
+
+	manageScopes, err := ScopesForManage([]Service{testService})
+	if err != nil {
+		t.Fatalf("ScopesForManage: %v", err)
+	}
+
+	if !containsScope(manageScopes, "https://www.googleapis.com/auth/synthetic") {
+		t.Fatalf("ScopesForManage missing registered scope in %v", manageScopes)
+	}
+}
+
+func TestRegister_DuplicateErrors(t *testing.T) {
+	registerTestService(t)
+
+	if err := Register(testService, ServiceInfo{Scopes: []string{"https://www.googleapis.com/auth/synthetic"}}); err == nil {
+		t.Fatalf("expected error registering duplicate service")
+	}
+}
+
+func TestRegister_RequiresScopes(t *testing.T) {
+	if err := Register(Service("no-scopes"), ServiceInfo{}); err == nil {
+		t.Fatalf("expected error registering service without scopes")
+	}
+}
+
+func TestUnregister_IsNoOpForUnknownService(t *testing.T) {
+	Unregister(Service("never-registered"))
+}
+
+func TestMustRegister_PanicsOnInvalidInfo(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic")
+		}
+	}()
+
+	MustRegister(Service("panics"), ServiceInfo{})
+}
+
+func TestAllServices_BuiltinsOrderedBeforeCustom(t *testing.T) {
+	registerTestService(t)
+
+	all := AllServices()
+	if all[len(all)-1] != testService {
+		t.Fatalf("expected custom service last, got %v", all)
+	}
+}
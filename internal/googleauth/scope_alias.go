@@ -0,0 +1,123 @@
+package googleauth
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var errUnknownScopeAlias = errors.New("unknown scope alias")
+
+// scopeAliases maps short, gcloud-style scope names to their canonical
+// "https://www.googleapis.com/auth/..." form, mirroring the shorthand
+// accepted by gcloud/Terraform's canonicalizeServiceScope. Keys are the
+// last path segment(s) of the scopes this package already knows about,
+// a few short service names ("mail", "gmail", "docs", "sheets"), and a
+// handful of common aliases that don't map to any service here.
+var scopeAliases = map[string]string{
+	"mail":                           "https://mail.google.com/",
+	"gmail":                          "https://mail.google.com/",
+	"gmail.settings.basic":           "https://www.googleapis.com/auth/gmail.settings.basic",
+	"gmail.readonly":                 "https://www.googleapis.com/auth/gmail.readonly",
+	"gmail.send":                     "https://www.googleapis.com/auth/gmail.send",
+	"gmail.compose":                  "https://www.googleapis.com/auth/gmail.compose",
+	"calendar":                       "https://www.googleapis.com/auth/calendar",
+	"calendar.readonly":              "https://www.googleapis.com/auth/calendar.readonly",
+	"drive":                          "https://www.googleapis.com/auth/drive",
+	"drive.readonly":                 "https://www.googleapis.com/auth/drive.readonly",
+	"drive.metadata.readonly":        "https://www.googleapis.com/auth/drive.metadata.readonly",
+	"documents":                      "https://www.googleapis.com/auth/documents",
+	"docs":                           "https://www.googleapis.com/auth/documents",
+	"documents.readonly":             "https://www.googleapis.com/auth/documents.readonly",
+	"contacts":                       "https://www.googleapis.com/auth/contacts",
+	"contacts.readonly":              "https://www.googleapis.com/auth/contacts.readonly",
+	"contacts.other.readonly":        "https://www.googleapis.com/auth/contacts.other.readonly",
+	"directory.readonly":             "https://www.googleapis.com/auth/directory.readonly",
+	"tasks":                          "https://www.googleapis.com/auth/tasks",
+	"tasks.readonly":                 "https://www.googleapis.com/auth/tasks.readonly",
+	"spreadsheets":                   "https://www.googleapis.com/auth/spreadsheets",
+	"sheets":                         "https://www.googleapis.com/auth/spreadsheets",
+	"spreadsheets.readonly":          "https://www.googleapis.com/auth/spreadsheets.readonly",
+	"cloud-identity.groups.readonly": "https://www.googleapis.com/auth/cloud-identity.groups.readonly",
+	"keep":                           "https://www.googleapis.com/auth/keep",
+	"keep.readonly":                  "https://www.googleapis.com/auth/keep.readonly",
+	"userinfo.email":                 scopeUserinfoEmail,
+	"userinfo.profile":               "https://www.googleapis.com/auth/userinfo.profile",
+	"cloud-platform":                 "https://www.googleapis.com/auth/cloud-platform",
+	"cloud-platform.read-only":       "https://www.googleapis.com/auth/cloud-platform.read-only",
+}
+
+// CanonicalizeScope resolves a short alias (e.g. "drive.readonly",
+// "cloud-platform") to its full "https://www.googleapis.com/auth/..." scope
+// URL. Inputs that are already full scope URLs, or that carry no known
+// alias, are returned with only surrounding whitespace trimmed.
+func CanonicalizeScope(scope string) string {
+	s := strings.TrimSpace(scope)
+	if canon, ok := scopeAliases[s]; ok {
+		return canon
+	}
+
+	return s
+}
+
+// ParseScopes resolves a mixed list of aliases and full scope URLs to their
+// canonical form, deduplicating and sorting the result. It returns an error
+// if any entry is neither a known alias nor a recognizable full scope URL.
+func ParseScopes(scopes []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(scopes))
+	out := make([]string, 0, len(scopes))
+
+	for _, raw := range scopes {
+		s := strings.TrimSpace(raw)
+		if s == "" {
+			continue
+		}
+
+		canon, ok := resolveScope(s)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", errUnknownScopeAlias, raw)
+		}
+
+		if _, dup := seen[canon]; dup {
+			continue
+		}
+
+		seen[canon] = struct{}{}
+		out = append(out, canon)
+	}
+
+	sort.Strings(out)
+
+	return out, nil
+}
+
+// resolveScope resolves a single alias or full scope URL, reporting whether
+// it was recognized at all.
+func resolveScope(s string) (string, bool) {
+	if canon, ok := scopeAliases[s]; ok {
+		return canon, true
+	}
+
+	if isFullScopeURL(s) {
+		return s, true
+	}
+
+	return "", false
+}
+
+// isFullScopeURL reports whether s already looks like a scope this package
+// can pass through unresolved: a full googleapis scope URL, or one of the
+// bare OIDC scopes Google accepts alongside URLs.
+func isFullScopeURL(s string) bool {
+	if strings.HasPrefix(s, "https://") {
+		return true
+	}
+
+	switch s {
+	case scopeOpenID, scopeEmail, "profile":
+		return true
+	default:
+		return false
+	}
+}
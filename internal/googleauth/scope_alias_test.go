@@ -0,0 +1,100 @@
+package googleauth
+
+import "testing"
+
+func TestCanonicalizeScope(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"cloud-platform", "https://www.googleapis.com/auth/cloud-platform"},
+		{"userinfo.email", scopeUserinfoEmail},
+		{"drive.readonly", "https://www.googleapis.com/auth/drive.readonly"},
+		{"drive", "https://www.googleapis.com/auth/drive"},
+		{"https://www.googleapis.com/auth/calendar", "https://www.googleapis.com/auth/calendar"},
+		{"not-an-alias", "not-an-alias"},
+	}
+
+	for _, tt := range tests {
+		if got := CanonicalizeScope(tt.in); got != tt.want {
+			t.Fatalf("CanonicalizeScope(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCanonicalizeScope_NoCollisionBetweenDriveAndReadonly(t *testing.T) {
+	full := CanonicalizeScope("drive")
+	readonly := CanonicalizeScope("drive.readonly")
+
+	if full == readonly {
+		t.Fatalf("expected distinct scopes, got %q for both", full)
+	}
+}
+
+func TestParseScopes(t *testing.T) {
+	got, err := ParseScopes([]string{"drive", "gmail.readonly", "drive", "https://www.googleapis.com/auth/tasks"})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	want := []string{
+		"https://www.googleapis.com/auth/drive",
+		"https://www.googleapis.com/auth/gmail.readonly",
+		"https://www.googleapis.com/auth/tasks",
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for i, w := range want {
+		if got[i] != w {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestParseScopes_UnknownAlias(t *testing.T) {
+	if _, err := ParseScopes([]string{"not-a-real-scope"}); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestScopes_WithExtraAliases(t *testing.T) {
+	scopes, err := Scopes(ServiceTasks, "drive.readonly")
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if !containsScope(scopes, "https://www.googleapis.com/auth/drive.readonly") {
+		t.Fatalf("missing extra scope in %v", scopes)
+	}
+}
+
+func TestScopesForServices_WithExtraAliases(t *testing.T) {
+	scopes, err := ScopesForServices([]Service{ServiceGmail}, "cloud-platform")
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if !containsScope(scopes, "https://www.googleapis.com/auth/cloud-platform") {
+		t.Fatalf("missing extra scope in %v", scopes)
+	}
+}
+
+func TestScopesForManage_WithExtraAliases(t *testing.T) {
+	scopes, err := ScopesForManage([]Service{ServiceDrive}, "userinfo.profile")
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if !containsScope(scopes, "https://www.googleapis.com/auth/userinfo.profile") {
+		t.Fatalf("missing extra scope in %v", scopes)
+	}
+}
+
+func TestScopesForManage_UnknownExtraAliasErrors(t *testing.T) {
+	if _, err := ScopesForManage([]Service{ServiceDrive}, "bogus"); err == nil {
+		t.Fatalf("expected error")
+	}
+}
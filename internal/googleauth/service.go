@@ -32,12 +32,19 @@ var errUnknownService = errors.New("unknown service")
 
 type serviceInfo struct {
 	scopes []string
-	user   bool
-	apis   []string
-	note   string
+	// readOnlyScopes, when non-empty, is the scope set granted for
+	// ModeReadOnly instead of scopes. Services without a reduced-permission
+	// tier leave this nil and always grant the full scopes.
+	readOnlyScopes []string
+	user           bool
+	apis           []string
+	note           string
 }
 
-var serviceOrder = []Service{
+// builtinServiceOrder is the registration order for the services this
+// package ships with. Register appends custom services after these, so
+// built-ins always sort first.
+var builtinServiceOrder = []Service{
 	ServiceGmail,
 	ServiceCalendar,
 	ServiceDrive,
@@ -50,24 +57,30 @@ var serviceOrder = []Service{
 	ServiceKeep,
 }
 
-var serviceInfoByService = map[Service]serviceInfo{
+var builtinServiceInfo = map[Service]serviceInfo{
 	ServiceGmail: {
 		scopes: []string{
 			"https://mail.google.com/",
 			"https://www.googleapis.com/auth/gmail.settings.basic",
 		},
-		user: true,
-		apis: []string{"Gmail API"},
+		readOnlyScopes: []string{"https://www.googleapis.com/auth/gmail.readonly"},
+		user:           true,
+		apis:           []string{"Gmail API"},
 	},
 	ServiceCalendar: {
-		scopes: []string{"https://www.googleapis.com/auth/calendar"},
-		user:   true,
-		apis:   []string{"Calendar API"},
+		scopes:         []string{"https://www.googleapis.com/auth/calendar"},
+		readOnlyScopes: []string{"https://www.googleapis.com/auth/calendar.readonly"},
+		user:           true,
+		apis:           []string{"Calendar API"},
 	},
 	ServiceDrive: {
 		scopes: []string{"https://www.googleapis.com/auth/drive"},
-		user:   true,
-		apis:   []string{"Drive API"},
+		readOnlyScopes: []string{
+			"https://www.googleapis.com/auth/drive.readonly",
+			"https://www.googleapis.com/auth/drive.metadata.readonly",
+		},
+		user: true,
+		apis: []string{"Drive API"},
 	},
 	ServiceDocs: {
 		// Docs commands are implemented via Drive APIs (export/copy/create),
@@ -86,14 +99,20 @@ var serviceInfoByService = map[Service]serviceInfo{
 			"https://www.googleapis.com/auth/contacts.other.readonly",
 			"https://www.googleapis.com/auth/directory.readonly",
 		},
+		readOnlyScopes: []string{
+			"https://www.googleapis.com/auth/contacts.readonly",
+			"https://www.googleapis.com/auth/contacts.other.readonly",
+			"https://www.googleapis.com/auth/directory.readonly",
+		},
 		user: true,
 		apis: []string{"People API"},
 		note: "Contacts + other contacts + directory",
 	},
 	ServiceTasks: {
-		scopes: []string{"https://www.googleapis.com/auth/tasks"},
-		user:   true,
-		apis:   []string{"Tasks API"},
+		scopes:         []string{"https://www.googleapis.com/auth/tasks"},
+		readOnlyScopes: []string{"https://www.googleapis.com/auth/tasks.readonly"},
+		user:           true,
+		apis:           []string{"Tasks API"},
 	},
 	ServicePeople: {
 		// Needed for "people/me" requests.
@@ -103,10 +122,11 @@ var serviceInfoByService = map[Service]serviceInfo{
 		note:   "OIDC profile scope",
 	},
 	ServiceSheets: {
-		scopes: []string{"https://www.googleapis.com/auth/spreadsheets"},
-		user:   true,
-		apis:   []string{"Sheets API", "Drive API"},
-		note:   "Export via Drive",
+		scopes:         []string{"https://www.googleapis.com/auth/spreadsheets"},
+		readOnlyScopes: []string{"https://www.googleapis.com/auth/spreadsheets.readonly"},
+		user:           true,
+		apis:           []string{"Sheets API", "Drive API"},
+		note:           "Export via Drive",
 	},
 	ServiceGroups: {
 		scopes: []string{"https://www.googleapis.com/auth/cloud-identity.groups.readonly"},
@@ -124,7 +144,7 @@ var serviceInfoByService = map[Service]serviceInfo{
 
 func ParseService(s string) (Service, error) {
 	parsed := Service(strings.ToLower(strings.TrimSpace(s)))
-	if _, ok := serviceInfoByService[parsed]; ok {
+	if _, ok := reg.get(parsed); ok {
 		return parsed, nil
 	}
 
@@ -137,43 +157,56 @@ func UserServices() []Service {
 }
 
 func AllServices() []Service {
-	out := make([]Service, len(serviceOrder))
-	copy(out, serviceOrder)
-
-	return out
+	return reg.serviceOrder()
 }
 
-func Scopes(service Service) ([]string, error) {
-	info, ok := serviceInfoByService[service]
+// Scopes returns the scopes registered for service. Any extra scopes or
+// aliases (e.g. "drive.readonly", "cloud-platform") are canonicalized via
+// ParseScopes and merged in.
+func Scopes(service Service, extra ...string) ([]string, error) {
+	info, ok := reg.get(service)
 	if !ok {
 		return nil, errUnknownService
 	}
 
-	return append([]string(nil), info.scopes...), nil
+	scopes := append([]string(nil), info.scopes...)
+	if len(extra) == 0 {
+		return scopes, nil
+	}
+
+	parsedExtra, err := ParseScopes(extra)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeScopes(scopes, parsedExtra), nil
 }
 
 type ServiceInfo struct {
-	Service Service  `json:"service"`
-	User    bool     `json:"user"`
-	Scopes  []string `json:"scopes"`
-	APIs    []string `json:"apis,omitempty"`
-	Note    string   `json:"note,omitempty"`
+	Service        Service  `json:"service"`
+	User           bool     `json:"user"`
+	Scopes         []string `json:"scopes"`
+	ReadOnlyScopes []string `json:"readOnlyScopes,omitempty"`
+	APIs           []string `json:"apis,omitempty"`
+	Note           string   `json:"note,omitempty"`
 }
 
 func ServicesInfo() []ServiceInfo {
-	out := make([]ServiceInfo, 0, len(serviceOrder))
-	for _, svc := range serviceOrder {
-		info, ok := serviceInfoByService[svc]
+	order := reg.serviceOrder()
+	out := make([]ServiceInfo, 0, len(order))
+	for _, svc := range order {
+		info, ok := reg.get(svc)
 		if !ok {
 			continue
 		}
 
 		out = append(out, ServiceInfo{
-			Service: svc,
-			User:    info.user,
-			Scopes:  append([]string(nil), info.scopes...),
-			APIs:    append([]string(nil), info.apis...),
-			Note:    info.note,
+			Service:        svc,
+			User:           info.user,
+			Scopes:         append([]string(nil), info.scopes...),
+			ReadOnlyScopes: append([]string(nil), info.readOnlyScopes...),
+			APIs:           append([]string(nil), info.apis...),
+			Note:           info.note,
 		})
 	}
 
@@ -185,8 +218,8 @@ func ServicesMarkdown(infos []ServiceInfo) string {
 		return ""
 	}
 	var b strings.Builder
-	b.WriteString("| Service | User | APIs | Scopes | Notes |\n")
-	b.WriteString("| --- | --- | --- | --- | --- |\n")
+	b.WriteString("| Service | User | APIs | Scopes | Read-only scopes | Notes |\n")
+	b.WriteString("| --- | --- | --- | --- | --- | --- |\n")
 
 	for _, info := range infos {
 		userLabel := "no"
@@ -203,6 +236,8 @@ func ServicesMarkdown(infos []ServiceInfo) string {
 		b.WriteString(" | ")
 		b.WriteString(markdownScopes(info.Scopes))
 		b.WriteString(" | ")
+		b.WriteString(markdownScopes(info.ReadOnlyScopes))
+		b.WriteString(" | ")
 		b.WriteString(info.Note)
 		b.WriteString(" |\n")
 	}
@@ -223,7 +258,10 @@ func markdownScopes(scopes []string) string {
 	return strings.Join(parts, "<br>")
 }
 
-func ScopesForServices(services []Service) ([]string, error) {
+// ScopesForServices returns the union of scopes for services. extra accepts
+// additional scopes or aliases (e.g. from a CLI --scope flag) that are
+// canonicalized via ParseScopes and merged into the result.
+func ScopesForServices(services []Service, extra ...string) ([]string, error) {
 	set := make(map[string]struct{})
 
 	for _, svc := range services {
@@ -236,6 +274,17 @@ func ScopesForServices(services []Service) ([]string, error) {
 			set[s] = struct{}{}
 		}
 	}
+
+	if len(extra) > 0 {
+		parsedExtra, err := ParseScopes(extra)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range parsedExtra {
+			set[s] = struct{}{}
+		}
+	}
 	out := make([]string, 0, len(set))
 
 	for s := range set {
@@ -247,8 +296,8 @@ func ScopesForServices(services []Service) ([]string, error) {
 	return out, nil
 }
 
-func ScopesForManage(services []Service) ([]string, error) {
-	scopes, err := ScopesForServices(services)
+func ScopesForManage(services []Service, extra ...string) ([]string, error) {
+	scopes, err := ScopesForServices(services, extra...)
 	if err != nil {
 		return nil, err
 	}
@@ -299,9 +348,10 @@ func serviceNames(services []Service, sep string) string {
 }
 
 func filteredServices(include func(info serviceInfo) bool) []Service {
-	out := make([]Service, 0, len(serviceOrder))
-	for _, svc := range serviceOrder {
-		info, ok := serviceInfoByService[svc]
+	order := reg.serviceOrder()
+	out := make([]Service, 0, len(order))
+	for _, svc := range order {
+		info, ok := reg.get(svc)
 		if !ok {
 			continue
 		}
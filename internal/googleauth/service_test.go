@@ -105,16 +105,17 @@ func TestUserServiceCSV(t *testing.T) {
 }
 
 func TestServiceOrderCoverage(t *testing.T) {
+	order := reg.serviceOrder()
 	seen := make(map[Service]bool)
-	for _, svc := range serviceOrder {
+	for _, svc := range order {
 		seen[svc] = true
 
-		if _, ok := serviceInfoByService[svc]; !ok {
+		if _, ok := reg.get(svc); !ok {
 			t.Fatalf("missing info for %q", svc)
 		}
 	}
 
-	for svc := range serviceInfoByService {
+	for _, svc := range builtinServiceOrder {
 		if !seen[svc] {
 			t.Fatalf("service %q missing from order", svc)
 		}
@@ -123,7 +124,7 @@ func TestServiceOrderCoverage(t *testing.T) {
 
 func TestServicesInfo_Metadata(t *testing.T) {
 	infos := ServicesInfo()
-	if len(infos) != len(serviceOrder) {
+	if len(infos) != len(reg.serviceOrder()) {
 		t.Fatalf("unexpected services info length: %d", len(infos))
 	}
 